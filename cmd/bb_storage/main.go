@@ -1,7 +1,9 @@
 package main
 
 import (
+	"crypto/tls"
 	"flag"
+	"io/ioutil"
 	"log"
 	"net"
 	"net/http"
@@ -14,7 +16,10 @@ import (
 	"github.com/buildbarn/bb-storage/pkg/blobstore/configuration"
 	"github.com/buildbarn/bb-storage/pkg/builder"
 	"github.com/buildbarn/bb-storage/pkg/cas"
+	configuration_tls "github.com/buildbarn/bb-storage/pkg/proto/configuration/tls"
 	"github.com/buildbarn/bb-storage/pkg/util"
+	util_tls "github.com/buildbarn/bb-storage/pkg/util/tls"
+	"github.com/golang/protobuf/jsonpb"
 	"github.com/grpc-ecosystem/go-grpc-prometheus"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
@@ -29,18 +34,76 @@ import (
 	"google.golang.org/genproto/googleapis/bytestream"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/status"
 )
 
+// loadSchedulerTLSConfiguration reads a JSON encoded ClientConfiguration
+// proto from disk. It is used to configure TLS when dialing schedulers.
+func loadSchedulerTLSConfiguration(path string) (*configuration_tls.ClientConfiguration, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var configuration configuration_tls.ClientConfiguration
+	if err := jsonpb.UnmarshalString(string(data), &configuration); err != nil {
+		return nil, err
+	}
+	return &configuration, nil
+}
+
+// createSchedulerDialOptions builds the grpc.DialOption slice used to
+// dial a scheduler. When path is empty, schedulers are dialed over
+// plaintext; otherwise path is read as a JSON encoded ClientConfiguration
+// proto and used to configure TLS.
+func createSchedulerDialOptions(path string) ([]grpc.DialOption, error) {
+	if path == "" {
+		return []grpc.DialOption{
+			grpc.WithInsecure(),
+			grpc.WithUnaryInterceptor(grpc_prometheus.UnaryClientInterceptor),
+			grpc.WithStreamInterceptor(grpc_prometheus.StreamClientInterceptor),
+		}, nil
+	}
+	clientConfiguration, err := loadSchedulerTLSConfiguration(path)
+	if err != nil {
+		return nil, err
+	}
+	tlsConfig, err := util_tls.NewTLSConfigFromClientConfiguration(clientConfiguration)
+	if err != nil {
+		return nil, err
+	}
+	return []grpc.DialOption{
+		grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)),
+		grpc.WithUnaryInterceptor(grpc_prometheus.UnaryClientInterceptor),
+		grpc.WithStreamInterceptor(grpc_prometheus.StreamClientInterceptor),
+	}, nil
+}
+
+// loadRPCServerTLSConfiguration reads a JSON encoded ServerConfiguration
+// proto from disk. It is used to configure TLS for the RPC server.
+func loadRPCServerTLSConfiguration(path string) (*configuration_tls.ServerConfiguration, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var configuration configuration_tls.ServerConfiguration
+	if err := jsonpb.UnmarshalString(string(data), &configuration); err != nil {
+		return nil, err
+	}
+	return &configuration, nil
+}
+
 func main() {
 	var (
 		blobstoreConfig    = flag.String("blobstore-config", "/config/blobstore.conf", "Configuration for blob storage")
 		webListenAddress   = flag.String("web.listen-address", ":80", "Port on which to expose metrics")
 		ocagentAddress     = flag.String("ocagent.address", "", "Address of the opencensus agent, optional")
 		ocagentServiceName = flag.String("ocagent.service-name", "bb-storage", "Opencensus service name")
+		schedulerTLSConfig = flag.String("scheduler-tls-config", "", "Configuration for TLS used when dialing schedulers, optional")
+		rpcServerTLSConfig = flag.String("tls-config", "", "Configuration for TLS used by the RPC server, optional. When unset, the RPC server accepts plaintext connections")
 	)
 	var schedulersList util.StringList
-	flag.Var(&schedulersList, "scheduler", "Backend capable of executing build actions. Example: debian8|hostname-of-debian8-scheduler:8981")
+	flag.Var(&schedulersList, "scheduler", "Backend capable of executing build actions. Example: debian8|hostname-of-debian8-scheduler:8981. An optional third '|' separated component overrides -scheduler-tls-config for this scheduler only: debian8|hostname-of-debian8-scheduler:8981|/config/debian8-tls.conf")
 	var allowActionCacheUpdatesForInstancesList util.StringList
 	flag.Var(&allowActionCacheUpdatesForInstancesList, "allow-ac-updates-for-instance", "Allow clients to write into the action cache for this instance")
 	flag.Parse()
@@ -96,17 +159,29 @@ func main() {
 		}
 	}
 
+	// Dial options used to connect to schedulers for which -scheduler
+	// does not override the TLS configuration individually. By default
+	// schedulers are dialed over plaintext, unless a ClientConfiguration
+	// is provided.
+	defaultSchedulerDialOptions, err := createSchedulerDialOptions(*schedulerTLSConfig)
+	if err != nil {
+		log.Fatal("Failed to create default scheduler TLS configuration: ", err)
+	}
+
 	// Backends capable of compiling.
 	for _, schedulerEntry := range schedulersList {
-		components := strings.SplitN(schedulerEntry, "|", 2)
-		if len(components) != 2 {
+		components := strings.SplitN(schedulerEntry, "|", 3)
+		if len(components) < 2 {
 			log.Fatal("Invalid scheduler entry: ", schedulerEntry)
 		}
-		scheduler, err := grpc.Dial(
-			components[1],
-			grpc.WithInsecure(),
-			grpc.WithUnaryInterceptor(grpc_prometheus.UnaryClientInterceptor),
-			grpc.WithStreamInterceptor(grpc_prometheus.StreamClientInterceptor))
+		schedulerDialOptions := defaultSchedulerDialOptions
+		if len(components) == 3 && components[2] != "" {
+			schedulerDialOptions, err = createSchedulerDialOptions(components[2])
+			if err != nil {
+				log.Fatalf("Failed to create scheduler TLS configuration for %#v: %s", components[0], err)
+			}
+		}
+		scheduler, err := grpc.Dial(components[1], schedulerDialOptions...)
 		if err != nil {
 			log.Fatal("Failed to create scheduler RPC client: ", err)
 		}
@@ -138,6 +213,20 @@ func main() {
 	if err != nil {
 		log.Fatal("Failed to create listening socket: ", err)
 	}
+	if *rpcServerTLSConfig != "" {
+		serverConfiguration, err := loadRPCServerTLSConfiguration(*rpcServerTLSConfig)
+		if err != nil {
+			log.Fatal("Failed to load RPC server TLS configuration: ", err)
+		}
+		tlsConfig, acmeHTTPHandler, err := util_tls.NewTLSConfigFromServerConfiguration(serverConfiguration)
+		if err != nil {
+			log.Fatal("Failed to create RPC server TLS configuration: ", err)
+		}
+		if acmeHTTPHandler != nil {
+			http.Handle("/.well-known/acme-challenge/", acmeHTTPHandler)
+		}
+		sock = tls.NewListener(sock, tlsConfig)
+	}
 	if err := s.Serve(sock); err != nil {
 		log.Fatal("Failed to serve RPC server: ", err)
 	}
@@ -9,6 +9,7 @@ package tls
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -20,16 +21,198 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// TLSVersion enumerates the versions of the TLS protocol (and its
+// predecessor, SSL) that may be used as a lower or upper bound for a
+// connection, corresponding to the tls.VersionXXX constants in Go's
+// crypto/tls package.
+type TLSVersion int32
+
+const (
+	// No explicit bound is requested. Go's default bounds are used.
+	TLSVersion_TLS_VERSION_UNKNOWN TLSVersion = 0
+	TLSVersion_TLS_VERSION_SSL3    TLSVersion = 1
+	TLSVersion_TLS_VERSION_TLS1_0  TLSVersion = 2
+	TLSVersion_TLS_VERSION_TLS1_1  TLSVersion = 3
+	TLSVersion_TLS_VERSION_TLS1_2  TLSVersion = 4
+	TLSVersion_TLS_VERSION_TLS1_3  TLSVersion = 5
+)
+
+var (
+	TLSVersion_name = map[int32]string{
+		0: "TLS_VERSION_UNKNOWN",
+		1: "TLS_VERSION_SSL3",
+		2: "TLS_VERSION_TLS1_0",
+		3: "TLS_VERSION_TLS1_1",
+		4: "TLS_VERSION_TLS1_2",
+		5: "TLS_VERSION_TLS1_3",
+	}
+	TLSVersion_value = map[string]int32{
+		"TLS_VERSION_UNKNOWN": 0,
+		"TLS_VERSION_SSL3":    1,
+		"TLS_VERSION_TLS1_0":  2,
+		"TLS_VERSION_TLS1_1":  3,
+		"TLS_VERSION_TLS1_2":  4,
+		"TLS_VERSION_TLS1_3":  5,
+	}
+)
+
+func (x TLSVersion) Enum() *TLSVersion {
+	p := new(TLSVersion)
+	*p = x
+	return p
+}
+
+func (x TLSVersion) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TLSVersion) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_proto_configuration_tls_tls_proto_enumTypes[0].Descriptor()
+}
+
+func (TLSVersion) Type() protoreflect.EnumType {
+	return &file_pkg_proto_configuration_tls_tls_proto_enumTypes[0]
+}
+
+func (x TLSVersion) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TLSVersion.Descriptor instead.
+func (TLSVersion) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_proto_configuration_tls_tls_proto_rawDescGZIP(), []int{0}
+}
+
+// Renegotiation controls what types of TLS renegotiation are supported
+// by a connection, corresponding to the tls.RenegotiationSupport
+// constants in Go's crypto/tls package.
+type Renegotiation int32
+
+const (
+	// Renegotiation is not supported. This is the correct choice for the
+	// vast majority of applications.
+	Renegotiation_RENEGOTIATION_NEVER            Renegotiation = 0
+	Renegotiation_RENEGOTIATION_ONCE_AS_CLIENT   Renegotiation = 1
+	Renegotiation_RENEGOTIATION_FREELY_AS_CLIENT Renegotiation = 2
+)
+
+var (
+	Renegotiation_name = map[int32]string{
+		0: "RENEGOTIATION_NEVER",
+		1: "RENEGOTIATION_ONCE_AS_CLIENT",
+		2: "RENEGOTIATION_FREELY_AS_CLIENT",
+	}
+	Renegotiation_value = map[string]int32{
+		"RENEGOTIATION_NEVER":            0,
+		"RENEGOTIATION_ONCE_AS_CLIENT":   1,
+		"RENEGOTIATION_FREELY_AS_CLIENT": 2,
+	}
+)
+
+func (x Renegotiation) Enum() *Renegotiation {
+	p := new(Renegotiation)
+	*p = x
+	return p
+}
+
+func (x Renegotiation) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Renegotiation) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_proto_configuration_tls_tls_proto_enumTypes[1].Descriptor()
+}
+
+func (Renegotiation) Type() protoreflect.EnumType {
+	return &file_pkg_proto_configuration_tls_tls_proto_enumTypes[1]
+}
+
+func (x Renegotiation) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Renegotiation.Descriptor instead.
+func (Renegotiation) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_proto_configuration_tls_tls_proto_rawDescGZIP(), []int{1}
+}
+
+// AcmeChallengeType selects the ACME challenge type used to prove
+// control over the host names in an AcmeCertificateConfiguration.
+type AcmeChallengeType int32
+
+const (
+	// Complete the challenge by presenting a specially crafted
+	// certificate during the TLS handshake itself (RFC 8737). This is
+	// the default, as it requires no additional listener: it piggybacks
+	// on the server socket that is already being configured.
+	AcmeChallengeType_ACME_CHALLENGE_TYPE_TLS_ALPN_01 AcmeChallengeType = 0
+
+	// Complete the challenge by serving a token over plain HTTP on port
+	// 80. This requires mounting the http.Handler returned alongside
+	// this configuration's TLS configuration on that port.
+	AcmeChallengeType_ACME_CHALLENGE_TYPE_HTTP_01 AcmeChallengeType = 1
+)
+
+var (
+	AcmeChallengeType_name = map[int32]string{
+		0: "ACME_CHALLENGE_TYPE_TLS_ALPN_01",
+		1: "ACME_CHALLENGE_TYPE_HTTP_01",
+	}
+	AcmeChallengeType_value = map[string]int32{
+		"ACME_CHALLENGE_TYPE_TLS_ALPN_01": 0,
+		"ACME_CHALLENGE_TYPE_HTTP_01":     1,
+	}
+)
+
+func (x AcmeChallengeType) Enum() *AcmeChallengeType {
+	p := new(AcmeChallengeType)
+	*p = x
+	return p
+}
+
+func (x AcmeChallengeType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AcmeChallengeType) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_proto_configuration_tls_tls_proto_enumTypes[2].Descriptor()
+}
+
+func (AcmeChallengeType) Type() protoreflect.EnumType {
+	return &file_pkg_proto_configuration_tls_tls_proto_enumTypes[2]
+}
+
+func (x AcmeChallengeType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AcmeChallengeType.Descriptor instead.
+func (AcmeChallengeType) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_proto_configuration_tls_tls_proto_rawDescGZIP(), []int{2}
+}
+
+// ClientConfiguration specifies the TLS settings to use when this
+// process acts as a client, e.g. when dialing a scheduler.
 type ClientConfiguration struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ServerCertificateAuthorities string   `protobuf:"bytes,1,opt,name=server_certificate_authorities,json=serverCertificateAuthorities,proto3" json:"server_certificate_authorities,omitempty"`
-	ClientCertificate            string   `protobuf:"bytes,2,opt,name=client_certificate,json=clientCertificate,proto3" json:"client_certificate,omitempty"`
-	ClientPrivateKey             string   `protobuf:"bytes,3,opt,name=client_private_key,json=clientPrivateKey,proto3" json:"client_private_key,omitempty"`
-	CipherSuites                 []string `protobuf:"bytes,4,rep,name=cipher_suites,json=cipherSuites,proto3" json:"cipher_suites,omitempty"`
-	ServerName                   string   `protobuf:"bytes,5,opt,name=server_name,json=serverName,proto3" json:"server_name,omitempty"`
+	ServerCertificateAuthorities string               `protobuf:"bytes,1,opt,name=server_certificate_authorities,json=serverCertificateAuthorities,proto3" json:"server_certificate_authorities,omitempty"`
+	ClientCertificate            string               `protobuf:"bytes,2,opt,name=client_certificate,json=clientCertificate,proto3" json:"client_certificate,omitempty"`
+	CipherSuites                 []string             `protobuf:"bytes,4,rep,name=cipher_suites,json=cipherSuites,proto3" json:"cipher_suites,omitempty"`
+	ServerName                   string               `protobuf:"bytes,5,opt,name=server_name,json=serverName,proto3" json:"server_name,omitempty"`
+	ClientCertificatePath        string               `protobuf:"bytes,7,opt,name=client_certificate_path,json=clientCertificatePath,proto3" json:"client_certificate_path,omitempty"`
+	ReloadInterval               *durationpb.Duration `protobuf:"bytes,8,opt,name=reload_interval,json=reloadInterval,proto3" json:"reload_interval,omitempty"`
+	// Types that are assignable to PrivateKey:
+	//	*ClientConfiguration_ClientPrivateKey
+	//	*ClientConfiguration_RemoteSigner
+	//	*ClientConfiguration_ClientPrivateKeyPath
+	PrivateKey        isClientConfiguration_PrivateKey `protobuf_oneof:"private_key"`
+	MinimumTlsVersion TLSVersion                       `protobuf:"varint,10,opt,name=minimum_tls_version,json=minimumTlsVersion,proto3,enum=buildbarn.configuration.tls.TLSVersion" json:"minimum_tls_version,omitempty"`
+	MaximumTlsVersion TLSVersion                       `protobuf:"varint,11,opt,name=maximum_tls_version,json=maximumTlsVersion,proto3,enum=buildbarn.configuration.tls.TLSVersion" json:"maximum_tls_version,omitempty"`
+	CurvePreferences  []string                         `protobuf:"bytes,12,rep,name=curve_preferences,json=curvePreferences,proto3" json:"curve_preferences,omitempty"`
+	Renegotiation     Renegotiation                    `protobuf:"varint,13,opt,name=renegotiation,proto3,enum=buildbarn.configuration.tls.Renegotiation" json:"renegotiation,omitempty"`
 }
 
 func (x *ClientConfiguration) Reset() {
@@ -78,35 +261,135 @@ func (x *ClientConfiguration) GetClientCertificate() string {
 	return ""
 }
 
-func (x *ClientConfiguration) GetClientPrivateKey() string {
+func (x *ClientConfiguration) GetCipherSuites() []string {
+	if x != nil {
+		return x.CipherSuites
+	}
+	return nil
+}
+
+func (x *ClientConfiguration) GetServerName() string {
+	if x != nil {
+		return x.ServerName
+	}
+	return ""
+}
+
+func (x *ClientConfiguration) GetClientCertificatePath() string {
 	if x != nil {
+		return x.ClientCertificatePath
+	}
+	return ""
+}
+
+func (x *ClientConfiguration) GetReloadInterval() *durationpb.Duration {
+	if x != nil {
+		return x.ReloadInterval
+	}
+	return nil
+}
+
+func (m *ClientConfiguration) GetPrivateKey() isClientConfiguration_PrivateKey {
+	if m != nil {
+		return m.PrivateKey
+	}
+	return nil
+}
+
+func (x *ClientConfiguration) GetClientPrivateKey() string {
+	if x, ok := x.GetPrivateKey().(*ClientConfiguration_ClientPrivateKey); ok {
 		return x.ClientPrivateKey
 	}
 	return ""
 }
 
-func (x *ClientConfiguration) GetCipherSuites() []string {
+func (x *ClientConfiguration) GetRemoteSigner() *RemoteSigner {
+	if x, ok := x.GetPrivateKey().(*ClientConfiguration_RemoteSigner); ok {
+		return x.RemoteSigner
+	}
+	return nil
+}
+
+func (x *ClientConfiguration) GetClientPrivateKeyPath() string {
+	if x, ok := x.GetPrivateKey().(*ClientConfiguration_ClientPrivateKeyPath); ok {
+		return x.ClientPrivateKeyPath
+	}
+	return ""
+}
+
+func (x *ClientConfiguration) GetMinimumTlsVersion() TLSVersion {
 	if x != nil {
-		return x.CipherSuites
+		return x.MinimumTlsVersion
+	}
+	return TLSVersion_TLS_VERSION_UNKNOWN
+}
+
+func (x *ClientConfiguration) GetMaximumTlsVersion() TLSVersion {
+	if x != nil {
+		return x.MaximumTlsVersion
+	}
+	return TLSVersion_TLS_VERSION_UNKNOWN
+}
+
+func (x *ClientConfiguration) GetCurvePreferences() []string {
+	if x != nil {
+		return x.CurvePreferences
 	}
 	return nil
 }
 
-func (x *ClientConfiguration) GetServerName() string {
+func (x *ClientConfiguration) GetRenegotiation() Renegotiation {
 	if x != nil {
-		return x.ServerName
+		return x.Renegotiation
 	}
-	return ""
+	return Renegotiation_RENEGOTIATION_NEVER
 }
 
+type isClientConfiguration_PrivateKey interface {
+	isClientConfiguration_PrivateKey()
+}
+
+type ClientConfiguration_ClientPrivateKey struct {
+	// PEM data for the private key belonging to client_certificate.
+	ClientPrivateKey string `protobuf:"bytes,3,opt,name=client_private_key,json=clientPrivateKey,proto3,oneof"`
+}
+
+type ClientConfiguration_RemoteSigner struct {
+	// A remote signer holding the private key belonging to
+	// client_certificate on bb-storage's behalf.
+	RemoteSigner *RemoteSigner `protobuf:"bytes,6,opt,name=remote_signer,json=remoteSigner,proto3,oneof"`
+}
+
+type ClientConfiguration_ClientPrivateKeyPath struct {
+	// Path to a PEM encoded private key on disk, belonging to the
+	// certificate at client_certificate_path.
+	ClientPrivateKeyPath string `protobuf:"bytes,9,opt,name=client_private_key_path,json=clientPrivateKeyPath,proto3,oneof"`
+}
+
+func (*ClientConfiguration_ClientPrivateKey) isClientConfiguration_PrivateKey() {}
+
+func (*ClientConfiguration_RemoteSigner) isClientConfiguration_PrivateKey() {}
+
+func (*ClientConfiguration_ClientPrivateKeyPath) isClientConfiguration_PrivateKey() {}
+
+// ServerConfiguration specifies the TLS settings to use when this
+// process acts as a server, e.g. when exposing the RPC server of
+// bb-storage.
 type ServerConfiguration struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ServerCertificate string   `protobuf:"bytes,1,opt,name=server_certificate,json=serverCertificate,proto3" json:"server_certificate,omitempty"`
-	ServerPrivateKey  string   `protobuf:"bytes,2,opt,name=server_private_key,json=serverPrivateKey,proto3" json:"server_private_key,omitempty"`
-	CipherSuites      []string `protobuf:"bytes,3,rep,name=cipher_suites,json=cipherSuites,proto3" json:"cipher_suites,omitempty"`
+	CipherSuites                 []string `protobuf:"bytes,3,rep,name=cipher_suites,json=cipherSuites,proto3" json:"cipher_suites,omitempty"`
+	ClientCertificateAuthorities string   `protobuf:"bytes,4,opt,name=client_certificate_authorities,json=clientCertificateAuthorities,proto3" json:"client_certificate_authorities,omitempty"`
+	// Types that are assignable to Certificate:
+	//	*ServerConfiguration_Static
+	//	*ServerConfiguration_Acme
+	Certificate              isServerConfiguration_Certificate `protobuf_oneof:"certificate"`
+	MinimumTlsVersion        TLSVersion                        `protobuf:"varint,5,opt,name=minimum_tls_version,json=minimumTlsVersion,proto3,enum=buildbarn.configuration.tls.TLSVersion" json:"minimum_tls_version,omitempty"`
+	MaximumTlsVersion        TLSVersion                        `protobuf:"varint,6,opt,name=maximum_tls_version,json=maximumTlsVersion,proto3,enum=buildbarn.configuration.tls.TLSVersion" json:"maximum_tls_version,omitempty"`
+	CurvePreferences         []string                          `protobuf:"bytes,7,rep,name=curve_preferences,json=curvePreferences,proto3" json:"curve_preferences,omitempty"`
+	PreferServerCipherSuites bool                              `protobuf:"varint,8,opt,name=prefer_server_cipher_suites,json=preferServerCipherSuites,proto3" json:"prefer_server_cipher_suites,omitempty"`
 }
 
 func (x *ServerConfiguration) Reset() {
@@ -141,23 +424,395 @@ func (*ServerConfiguration) Descriptor() ([]byte, []int) {
 	return file_pkg_proto_configuration_tls_tls_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *ServerConfiguration) GetServerCertificate() string {
+func (x *ServerConfiguration) GetCipherSuites() []string {
+	if x != nil {
+		return x.CipherSuites
+	}
+	return nil
+}
+
+func (x *ServerConfiguration) GetClientCertificateAuthorities() string {
+	if x != nil {
+		return x.ClientCertificateAuthorities
+	}
+	return ""
+}
+
+func (m *ServerConfiguration) GetCertificate() isServerConfiguration_Certificate {
+	if m != nil {
+		return m.Certificate
+	}
+	return nil
+}
+
+func (x *ServerConfiguration) GetStatic() *StaticCertificateConfiguration {
+	if x, ok := x.GetCertificate().(*ServerConfiguration_Static); ok {
+		return x.Static
+	}
+	return nil
+}
+
+func (x *ServerConfiguration) GetAcme() *AcmeCertificateConfiguration {
+	if x, ok := x.GetCertificate().(*ServerConfiguration_Acme); ok {
+		return x.Acme
+	}
+	return nil
+}
+
+func (x *ServerConfiguration) GetMinimumTlsVersion() TLSVersion {
+	if x != nil {
+		return x.MinimumTlsVersion
+	}
+	return TLSVersion_TLS_VERSION_UNKNOWN
+}
+
+func (x *ServerConfiguration) GetMaximumTlsVersion() TLSVersion {
+	if x != nil {
+		return x.MaximumTlsVersion
+	}
+	return TLSVersion_TLS_VERSION_UNKNOWN
+}
+
+func (x *ServerConfiguration) GetCurvePreferences() []string {
+	if x != nil {
+		return x.CurvePreferences
+	}
+	return nil
+}
+
+func (x *ServerConfiguration) GetPreferServerCipherSuites() bool {
+	if x != nil {
+		return x.PreferServerCipherSuites
+	}
+	return false
+}
+
+type isServerConfiguration_Certificate interface {
+	isServerConfiguration_Certificate()
+}
+
+type ServerConfiguration_Static struct {
+	// Use a certificate that was provisioned ahead of time, either
+	// directly or through a remote signer.
+	Static *StaticCertificateConfiguration `protobuf:"bytes,9,opt,name=static,proto3,oneof"`
+}
+
+type ServerConfiguration_Acme struct {
+	// Automatically obtain and renew a certificate through the ACME
+	// protocol, e.g. from Let's Encrypt.
+	Acme *AcmeCertificateConfiguration `protobuf:"bytes,10,opt,name=acme,proto3,oneof"`
+}
+
+func (*ServerConfiguration_Static) isServerConfiguration_Certificate() {}
+
+func (*ServerConfiguration_Acme) isServerConfiguration_Certificate() {}
+
+// StaticCertificateConfiguration holds a certificate that was
+// provisioned ahead of time, e.g. by a human operator or a separate
+// certificate management system.
+type StaticCertificateConfiguration struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// PEM data for the certificate that should be presented to clients.
+	ServerCertificate string `protobuf:"bytes,1,opt,name=server_certificate,json=serverCertificate,proto3" json:"server_certificate,omitempty"`
+	// Path to a PEM encoded server certificate on disk, transparently
+	// reloaded whenever its contents change.
+	ServerCertificatePath string               `protobuf:"bytes,4,opt,name=server_certificate_path,json=serverCertificatePath,proto3" json:"server_certificate_path,omitempty"`
+	ReloadInterval        *durationpb.Duration `protobuf:"bytes,5,opt,name=reload_interval,json=reloadInterval,proto3" json:"reload_interval,omitempty"`
+	// Types that are assignable to PrivateKey:
+	//	*StaticCertificateConfiguration_ServerPrivateKey
+	//	*StaticCertificateConfiguration_RemoteSigner
+	//	*StaticCertificateConfiguration_ServerPrivateKeyPath
+	PrivateKey isStaticCertificateConfiguration_PrivateKey `protobuf_oneof:"private_key"`
+}
+
+func (x *StaticCertificateConfiguration) Reset() {
+	*x = StaticCertificateConfiguration{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_proto_configuration_tls_tls_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StaticCertificateConfiguration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StaticCertificateConfiguration) ProtoMessage() {}
+
+func (x *StaticCertificateConfiguration) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_configuration_tls_tls_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StaticCertificateConfiguration.ProtoReflect.Descriptor instead.
+func (*StaticCertificateConfiguration) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_configuration_tls_tls_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *StaticCertificateConfiguration) GetServerCertificate() string {
 	if x != nil {
 		return x.ServerCertificate
 	}
 	return ""
 }
 
-func (x *ServerConfiguration) GetServerPrivateKey() string {
+func (x *StaticCertificateConfiguration) GetServerCertificatePath() string {
+	if x != nil {
+		return x.ServerCertificatePath
+	}
+	return ""
+}
+
+func (x *StaticCertificateConfiguration) GetReloadInterval() *durationpb.Duration {
 	if x != nil {
+		return x.ReloadInterval
+	}
+	return nil
+}
+
+func (m *StaticCertificateConfiguration) GetPrivateKey() isStaticCertificateConfiguration_PrivateKey {
+	if m != nil {
+		return m.PrivateKey
+	}
+	return nil
+}
+
+func (x *StaticCertificateConfiguration) GetServerPrivateKey() string {
+	if x, ok := x.GetPrivateKey().(*StaticCertificateConfiguration_ServerPrivateKey); ok {
 		return x.ServerPrivateKey
 	}
 	return ""
 }
 
-func (x *ServerConfiguration) GetCipherSuites() []string {
+func (x *StaticCertificateConfiguration) GetRemoteSigner() *RemoteSigner {
+	if x, ok := x.GetPrivateKey().(*StaticCertificateConfiguration_RemoteSigner); ok {
+		return x.RemoteSigner
+	}
+	return nil
+}
+
+func (x *StaticCertificateConfiguration) GetServerPrivateKeyPath() string {
+	if x, ok := x.GetPrivateKey().(*StaticCertificateConfiguration_ServerPrivateKeyPath); ok {
+		return x.ServerPrivateKeyPath
+	}
+	return ""
+}
+
+type isStaticCertificateConfiguration_PrivateKey interface {
+	isStaticCertificateConfiguration_PrivateKey()
+}
+
+type StaticCertificateConfiguration_ServerPrivateKey struct {
+	// PEM data for the private key belonging to server_certificate.
+	ServerPrivateKey string `protobuf:"bytes,2,opt,name=server_private_key,json=serverPrivateKey,proto3,oneof"`
+}
+
+type StaticCertificateConfiguration_RemoteSigner struct {
+	// A remote signer holding the private key belonging to
+	// server_certificate on bb-storage's behalf.
+	RemoteSigner *RemoteSigner `protobuf:"bytes,3,opt,name=remote_signer,json=remoteSigner,proto3,oneof"`
+}
+
+type StaticCertificateConfiguration_ServerPrivateKeyPath struct {
+	// Path to a PEM encoded private key on disk, belonging to the
+	// certificate at server_certificate_path.
+	ServerPrivateKeyPath string `protobuf:"bytes,6,opt,name=server_private_key_path,json=serverPrivateKeyPath,proto3,oneof"`
+}
+
+func (*StaticCertificateConfiguration_ServerPrivateKey) isStaticCertificateConfiguration_PrivateKey() {
+}
+
+func (*StaticCertificateConfiguration_RemoteSigner) isStaticCertificateConfiguration_PrivateKey() {}
+
+func (*StaticCertificateConfiguration_ServerPrivateKeyPath) isStaticCertificateConfiguration_PrivateKey() {
+}
+
+// AcmeCertificateConfiguration causes bb-storage to automatically
+// obtain and renew a certificate through the ACME protocol (RFC 8555),
+// as implemented by certificate authorities such as Let's Encrypt.
+type AcmeCertificateConfiguration struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Host names for which a certificate should be requested. Incoming
+	// connections using TLS SNI for any other host name are rejected.
+	HostNames []string `protobuf:"bytes,1,rep,name=host_names,json=hostNames,proto3" json:"host_names,omitempty"`
+	// Contact email address to report to the ACME server, e.g. for
+	// expiry notifications. Optional.
+	Email string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	// URL of the ACME directory to use. When left empty, Let's
+	// Encrypt's production directory is used.
+	DirectoryUrl string `protobuf:"bytes,3,opt,name=directory_url,json=directoryUrl,proto3" json:"directory_url,omitempty"`
+	// Whether the operator of this bb-storage instance accepts the ACME
+	// server's terms of service. This must be set to true, as bb-storage
+	// refuses to request certificates on the operator's behalf
+	// otherwise.
+	AcceptTermsOfService bool `protobuf:"varint,4,opt,name=accept_terms_of_service,json=acceptTermsOfService,proto3" json:"accept_terms_of_service,omitempty"`
+	// Directory on disk in which obtained certificates, private keys
+	// and ACME account data are cached, so that they survive restarts
+	// and do not need to be reprovisioned on every startup.
+	CacheDirectory string `protobuf:"bytes,5,opt,name=cache_directory,json=cacheDirectory,proto3" json:"cache_directory,omitempty"`
+	// The ACME challenge type used to prove control over host_names.
+	// When left unset, ACME_CHALLENGE_TYPE_TLS_ALPN_01 is used.
+	ChallengeType AcmeChallengeType `protobuf:"varint,6,opt,name=challenge_type,json=challengeType,proto3,enum=buildbarn.configuration.tls.AcmeChallengeType" json:"challenge_type,omitempty"`
+}
+
+func (x *AcmeCertificateConfiguration) Reset() {
+	*x = AcmeCertificateConfiguration{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_proto_configuration_tls_tls_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AcmeCertificateConfiguration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcmeCertificateConfiguration) ProtoMessage() {}
+
+func (x *AcmeCertificateConfiguration) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_configuration_tls_tls_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcmeCertificateConfiguration.ProtoReflect.Descriptor instead.
+func (*AcmeCertificateConfiguration) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_configuration_tls_tls_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AcmeCertificateConfiguration) GetHostNames() []string {
 	if x != nil {
-		return x.CipherSuites
+		return x.HostNames
+	}
+	return nil
+}
+
+func (x *AcmeCertificateConfiguration) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *AcmeCertificateConfiguration) GetDirectoryUrl() string {
+	if x != nil {
+		return x.DirectoryUrl
+	}
+	return ""
+}
+
+func (x *AcmeCertificateConfiguration) GetAcceptTermsOfService() bool {
+	if x != nil {
+		return x.AcceptTermsOfService
+	}
+	return false
+}
+
+func (x *AcmeCertificateConfiguration) GetCacheDirectory() string {
+	if x != nil {
+		return x.CacheDirectory
+	}
+	return ""
+}
+
+func (x *AcmeCertificateConfiguration) GetChallengeType() AcmeChallengeType {
+	if x != nil {
+		return x.ChallengeType
+	}
+	return AcmeChallengeType_ACME_CHALLENGE_TYPE_TLS_ALPN_01
+}
+
+// RemoteSigner describes how to reach a signing service that holds a
+// TLS private key on bb-storage's behalf, inspired by Cloudflare's
+// Keyless SSL architecture. This allows bb-storage to terminate TLS
+// without the private key ever touching the storage node's
+// filesystem.
+type RemoteSigner struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Network address of the remote signer, e.g. "signer.example.com:443".
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// TLS client configuration used to connect to the remote signer.
+	// This is typically configured to use mTLS, so that the remote
+	// signer can authenticate bb-storage before producing a signature.
+	Client *ClientConfiguration `protobuf:"bytes,2,opt,name=client,proto3" json:"client,omitempty"`
+	// SHA-256 Subject Key Identifier of the key that the remote signer
+	// should use to sign on bb-storage's behalf.
+	KeySha256 []byte `protobuf:"bytes,3,opt,name=key_sha256,json=keySha256,proto3" json:"key_sha256,omitempty"`
+}
+
+func (x *RemoteSigner) Reset() {
+	*x = RemoteSigner{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_proto_configuration_tls_tls_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoteSigner) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoteSigner) ProtoMessage() {}
+
+func (x *RemoteSigner) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_configuration_tls_tls_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoteSigner.ProtoReflect.Descriptor instead.
+func (*RemoteSigner) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_configuration_tls_tls_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RemoteSigner) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *RemoteSigner) GetClient() *ClientConfiguration {
+	if x != nil {
+		return x.Client
+	}
+	return nil
+}
+
+func (x *RemoteSigner) GetKeySha256() []byte {
+	if x != nil {
+		return x.KeySha256
 	}
 	return nil
 }
@@ -169,7 +824,9 @@ var file_pkg_proto_configuration_tls_tls_proto_rawDesc = []byte{
 	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x74, 0x6c, 0x73, 0x2f, 0x74, 0x6c,
 	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1b, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x61,
 	0x72, 0x6e, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x2e, 0x74, 0x6c, 0x73, 0x22, 0xfe, 0x01, 0x0a, 0x13, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43,
+	0x2e, 0x74, 0x6c, 0x73, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc7, 0x06, 0x0a, 0x13, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43,
 	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x44, 0x0a, 0x1e,
 	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
 	0x74, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01,
@@ -178,28 +835,169 @@ var file_pkg_proto_configuration_tls_tls_proto_rawDesc = []byte{
 	0x65, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x65, 0x72,
 	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11,
 	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
-	0x65, 0x12, 0x2c, 0x0a, 0x12, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x76,
-	0x61, 0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x63,
-	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x12,
-	0x23, 0x0a, 0x0d, 0x63, 0x69, 0x70, 0x68, 0x65, 0x72, 0x5f, 0x73, 0x75, 0x69, 0x74, 0x65, 0x73,
-	0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x69, 0x70, 0x68, 0x65, 0x72, 0x53, 0x75,
-	0x69, 0x74, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x97, 0x01, 0x0a, 0x13, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a,
-	0x12, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63,
-	0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x2c, 0x0a, 0x12,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x6b,
-	0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x69,
-	0x70, 0x68, 0x65, 0x72, 0x5f, 0x73, 0x75, 0x69, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x0c, 0x63, 0x69, 0x70, 0x68, 0x65, 0x72, 0x53, 0x75, 0x69, 0x74, 0x65, 0x73, 0x42,
-	0x3d, 0x5a, 0x3b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x75,
-	0x69, 0x6c, 0x64, 0x62, 0x61, 0x72, 0x6e, 0x2f, 0x62, 0x62, 0x2d, 0x73, 0x74, 0x6f, 0x72, 0x61,
-	0x67, 0x65, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x74, 0x6c, 0x73, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x12, 0x2e, 0x0a, 0x12, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x76,
+	0x61, 0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x10, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65,
+	0x79, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x69, 0x70, 0x68, 0x65, 0x72, 0x5f, 0x73, 0x75, 0x69, 0x74,
+	0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x69, 0x70, 0x68, 0x65, 0x72,
+	0x53, 0x75, 0x69, 0x74, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x50, 0x0a, 0x0d, 0x72, 0x65, 0x6d, 0x6f, 0x74,
+	0x65, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29,
+	0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x61, 0x72, 0x6e, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x74, 0x6c, 0x73, 0x2e, 0x52, 0x65, 0x6d,
+	0x6f, 0x74, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x48, 0x00, 0x52, 0x0c, 0x72, 0x65, 0x6d,
+	0x6f, 0x74, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x36, 0x0a, 0x17, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f,
+	0x70, 0x61, 0x74, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x50, 0x61, 0x74,
+	0x68, 0x12, 0x42, 0x0a, 0x0f, 0x72, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x72, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x37, 0x0a, 0x17, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f,
+	0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x70, 0x61, 0x74, 0x68,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x14, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x50, 0x61, 0x74, 0x68, 0x12, 0x57,
+	0x0a, 0x13, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x74, 0x6c, 0x73, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x62, 0x75,
+	0x69, 0x6c, 0x64, 0x62, 0x61, 0x72, 0x6e, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x74, 0x6c, 0x73, 0x2e, 0x54, 0x4c, 0x53, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x11, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x54, 0x6c, 0x73,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x57, 0x0a, 0x13, 0x6d, 0x61, 0x78, 0x69, 0x6d,
+	0x75, 0x6d, 0x5f, 0x74, 0x6c, 0x73, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x61, 0x72, 0x6e,
+	0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x74,
+	0x6c, 0x73, 0x2e, 0x54, 0x4c, 0x53, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x11, 0x6d,
+	0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x54, 0x6c, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x2b, 0x0a, 0x11, 0x63, 0x75, 0x72, 0x76, 0x65, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x63, 0x75, 0x72,
+	0x76, 0x65, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x50, 0x0a,
+	0x0d, 0x72, 0x65, 0x6e, 0x65, 0x67, 0x6f, 0x74, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x2a, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x61, 0x72, 0x6e,
+	0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x74,
+	0x6c, 0x73, 0x2e, 0x52, 0x65, 0x6e, 0x65, 0x67, 0x6f, 0x74, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0d, 0x72, 0x65, 0x6e, 0x65, 0x67, 0x6f, 0x74, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42,
+	0x0d, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x22, 0xd5,
+	0x04, 0x0a, 0x13, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x69, 0x70, 0x68, 0x65, 0x72,
+	0x5f, 0x73, 0x75, 0x69, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x63,
+	0x69, 0x70, 0x68, 0x65, 0x72, 0x53, 0x75, 0x69, 0x74, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x1e, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x1c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x65, 0x72, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x69, 0x65,
+	0x73, 0x12, 0x55, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x69, 0x63, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x3b, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x61, 0x72, 0x6e, 0x2e, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x74, 0x6c, 0x73, 0x2e,
+	0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x69, 0x63, 0x12, 0x4f, 0x0a, 0x04, 0x61, 0x63, 0x6d, 0x65,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x61,
+	0x72, 0x6e, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x74, 0x6c, 0x73, 0x2e, 0x41, 0x63, 0x6d, 0x65, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x48, 0x00, 0x52, 0x04, 0x61, 0x63, 0x6d, 0x65, 0x12, 0x57, 0x0a, 0x13, 0x6d, 0x69, 0x6e,
+	0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x74, 0x6c, 0x73, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x61,
+	0x72, 0x6e, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x74, 0x6c, 0x73, 0x2e, 0x54, 0x4c, 0x53, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x11, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x54, 0x6c, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x57, 0x0a, 0x13, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x74, 0x6c,
+	0x73, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x27, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x61, 0x72, 0x6e, 0x2e, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x74, 0x6c, 0x73, 0x2e, 0x54, 0x4c,
+	0x53, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x11, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75,
+	0x6d, 0x54, 0x6c, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x11, 0x63,
+	0x75, 0x72, 0x76, 0x65, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73,
+	0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x63, 0x75, 0x72, 0x76, 0x65, 0x50, 0x72, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x3d, 0x0a, 0x1b, 0x70, 0x72, 0x65, 0x66,
+	0x65, 0x72, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x63, 0x69, 0x70, 0x68, 0x65, 0x72,
+	0x5f, 0x73, 0x75, 0x69, 0x74, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x18, 0x70,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x69, 0x70, 0x68, 0x65,
+	0x72, 0x53, 0x75, 0x69, 0x74, 0x65, 0x73, 0x42, 0x0d, 0x0a, 0x0b, 0x63, 0x65, 0x72, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x22, 0x95, 0x03, 0x0a, 0x1e, 0x53, 0x74, 0x61, 0x74, 0x69,
+	0x63, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x12, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x65, 0x72,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x2e, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x5f, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x50, 0x72,
+	0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x50, 0x0a, 0x0d, 0x72, 0x65, 0x6d, 0x6f,
+	0x74, 0x65, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x29, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x61, 0x72, 0x6e, 0x2e, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x74, 0x6c, 0x73, 0x2e, 0x52, 0x65,
+	0x6d, 0x6f, 0x74, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x48, 0x00, 0x52, 0x0c, 0x72, 0x65,
+	0x6d, 0x6f, 0x74, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x36, 0x0a, 0x17, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x50, 0x61,
+	0x74, 0x68, 0x12, 0x42, 0x0a, 0x0f, 0x72, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x72, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x37, 0x0a, 0x17, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x5f, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x70, 0x61, 0x74,
+	0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x14, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x50, 0x61, 0x74, 0x68, 0x42,
+	0x0d, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x22, 0xaf,
+	0x02, 0x0a, 0x1c, 0x41, 0x63, 0x6d, 0x65, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x1d, 0x0a, 0x0a, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x09, 0x68, 0x6f, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
+	0x6d, 0x61, 0x69, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x79, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x55, 0x72, 0x6c, 0x12, 0x35, 0x0a, 0x17, 0x61, 0x63, 0x63,
+	0x65, 0x70, 0x74, 0x5f, 0x74, 0x65, 0x72, 0x6d, 0x73, 0x5f, 0x6f, 0x66, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x61, 0x63, 0x63, 0x65,
+	0x70, 0x74, 0x54, 0x65, 0x72, 0x6d, 0x73, 0x4f, 0x66, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x27, 0x0a, 0x0f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x5f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x61, 0x63, 0x68, 0x65,
+	0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x55, 0x0a, 0x0e, 0x63, 0x68, 0x61,
+	0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x2e, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x61, 0x72, 0x6e, 0x2e, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x74, 0x6c, 0x73, 0x2e,
+	0x41, 0x63, 0x6d, 0x65, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x0d, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x22, 0x91, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x65,
+	0x72, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x48, 0x0a, 0x06, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x62, 0x75,
+	0x69, 0x6c, 0x64, 0x62, 0x61, 0x72, 0x6e, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x74, 0x6c, 0x73, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6b, 0x65, 0x79, 0x5f, 0x73, 0x68, 0x61,
+	0x32, 0x35, 0x36, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x6b, 0x65, 0x79, 0x53, 0x68,
+	0x61, 0x32, 0x35, 0x36, 0x2a, 0x9b, 0x01, 0x0a, 0x0a, 0x54, 0x4c, 0x53, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x13, 0x54, 0x4c, 0x53, 0x5f, 0x56, 0x45, 0x52, 0x53, 0x49,
+	0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10,
+	0x54, 0x4c, 0x53, 0x5f, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x53, 0x4c, 0x33,
+	0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x54, 0x4c, 0x53, 0x5f, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f,
+	0x4e, 0x5f, 0x54, 0x4c, 0x53, 0x31, 0x5f, 0x30, 0x10, 0x02, 0x12, 0x16, 0x0a, 0x12, 0x54, 0x4c,
+	0x53, 0x5f, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x4c, 0x53, 0x31, 0x5f, 0x31,
+	0x10, 0x03, 0x12, 0x16, 0x0a, 0x12, 0x54, 0x4c, 0x53, 0x5f, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f,
+	0x4e, 0x5f, 0x54, 0x4c, 0x53, 0x31, 0x5f, 0x32, 0x10, 0x04, 0x12, 0x16, 0x0a, 0x12, 0x54, 0x4c,
+	0x53, 0x5f, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x4c, 0x53, 0x31, 0x5f, 0x33,
+	0x10, 0x05, 0x2a, 0x6e, 0x0a, 0x0d, 0x52, 0x65, 0x6e, 0x65, 0x67, 0x6f, 0x74, 0x69, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x13, 0x52, 0x45, 0x4e, 0x45, 0x47, 0x4f, 0x54, 0x49, 0x41,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4e, 0x45, 0x56, 0x45, 0x52, 0x10, 0x00, 0x12, 0x20, 0x0a, 0x1c,
+	0x52, 0x45, 0x4e, 0x45, 0x47, 0x4f, 0x54, 0x49, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4f, 0x4e,
+	0x43, 0x45, 0x5f, 0x41, 0x53, 0x5f, 0x43, 0x4c, 0x49, 0x45, 0x4e, 0x54, 0x10, 0x01, 0x12, 0x22,
+	0x0a, 0x1e, 0x52, 0x45, 0x4e, 0x45, 0x47, 0x4f, 0x54, 0x49, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x46, 0x52, 0x45, 0x45, 0x4c, 0x59, 0x5f, 0x41, 0x53, 0x5f, 0x43, 0x4c, 0x49, 0x45, 0x4e, 0x54,
+	0x10, 0x02, 0x2a, 0x59, 0x0a, 0x11, 0x41, 0x63, 0x6d, 0x65, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65,
+	0x6e, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x23, 0x0a, 0x1f, 0x41, 0x43, 0x4d, 0x45, 0x5f,
+	0x43, 0x48, 0x41, 0x4c, 0x4c, 0x45, 0x4e, 0x47, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x54,
+	0x4c, 0x53, 0x5f, 0x41, 0x4c, 0x50, 0x4e, 0x5f, 0x30, 0x31, 0x10, 0x00, 0x12, 0x1f, 0x0a, 0x1b,
+	0x41, 0x43, 0x4d, 0x45, 0x5f, 0x43, 0x48, 0x41, 0x4c, 0x4c, 0x45, 0x4e, 0x47, 0x45, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x48, 0x54, 0x54, 0x50, 0x5f, 0x30, 0x31, 0x10, 0x01, 0x42, 0x3d, 0x5a,
+	0x3b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x75, 0x69, 0x6c,
+	0x64, 0x62, 0x61, 0x72, 0x6e, 0x2f, 0x62, 0x62, 0x2d, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x74, 0x6c, 0x73, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -214,17 +1012,38 @@ func file_pkg_proto_configuration_tls_tls_proto_rawDescGZIP() []byte {
 	return file_pkg_proto_configuration_tls_tls_proto_rawDescData
 }
 
-var file_pkg_proto_configuration_tls_tls_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_pkg_proto_configuration_tls_tls_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_pkg_proto_configuration_tls_tls_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_pkg_proto_configuration_tls_tls_proto_goTypes = []interface{}{
-	(*ClientConfiguration)(nil), // 0: buildbarn.configuration.tls.ClientConfiguration
-	(*ServerConfiguration)(nil), // 1: buildbarn.configuration.tls.ServerConfiguration
+	(TLSVersion)(0),                        // 0: buildbarn.configuration.tls.TLSVersion
+	(Renegotiation)(0),                     // 1: buildbarn.configuration.tls.Renegotiation
+	(AcmeChallengeType)(0),                 // 2: buildbarn.configuration.tls.AcmeChallengeType
+	(*ClientConfiguration)(nil),            // 3: buildbarn.configuration.tls.ClientConfiguration
+	(*ServerConfiguration)(nil),            // 4: buildbarn.configuration.tls.ServerConfiguration
+	(*StaticCertificateConfiguration)(nil), // 5: buildbarn.configuration.tls.StaticCertificateConfiguration
+	(*AcmeCertificateConfiguration)(nil),   // 6: buildbarn.configuration.tls.AcmeCertificateConfiguration
+	(*RemoteSigner)(nil),                   // 7: buildbarn.configuration.tls.RemoteSigner
+	(*durationpb.Duration)(nil),            // 8: google.protobuf.Duration
 }
 var file_pkg_proto_configuration_tls_tls_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	7,  // 0: buildbarn.configuration.tls.ClientConfiguration.remote_signer:type_name -> buildbarn.configuration.tls.RemoteSigner
+	8,  // 1: buildbarn.configuration.tls.ClientConfiguration.reload_interval:type_name -> google.protobuf.Duration
+	0,  // 2: buildbarn.configuration.tls.ClientConfiguration.minimum_tls_version:type_name -> buildbarn.configuration.tls.TLSVersion
+	0,  // 3: buildbarn.configuration.tls.ClientConfiguration.maximum_tls_version:type_name -> buildbarn.configuration.tls.TLSVersion
+	1,  // 4: buildbarn.configuration.tls.ClientConfiguration.renegotiation:type_name -> buildbarn.configuration.tls.Renegotiation
+	5,  // 5: buildbarn.configuration.tls.ServerConfiguration.static:type_name -> buildbarn.configuration.tls.StaticCertificateConfiguration
+	6,  // 6: buildbarn.configuration.tls.ServerConfiguration.acme:type_name -> buildbarn.configuration.tls.AcmeCertificateConfiguration
+	0,  // 7: buildbarn.configuration.tls.ServerConfiguration.minimum_tls_version:type_name -> buildbarn.configuration.tls.TLSVersion
+	0,  // 8: buildbarn.configuration.tls.ServerConfiguration.maximum_tls_version:type_name -> buildbarn.configuration.tls.TLSVersion
+	7,  // 9: buildbarn.configuration.tls.StaticCertificateConfiguration.remote_signer:type_name -> buildbarn.configuration.tls.RemoteSigner
+	8,  // 10: buildbarn.configuration.tls.StaticCertificateConfiguration.reload_interval:type_name -> google.protobuf.Duration
+	2,  // 11: buildbarn.configuration.tls.AcmeCertificateConfiguration.challenge_type:type_name -> buildbarn.configuration.tls.AcmeChallengeType
+	3,  // 12: buildbarn.configuration.tls.RemoteSigner.client:type_name -> buildbarn.configuration.tls.ClientConfiguration
+	13, // [13:13] is the sub-list for method output_type
+	13, // [13:13] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_pkg_proto_configuration_tls_tls_proto_init() }
@@ -257,19 +1076,70 @@ func file_pkg_proto_configuration_tls_tls_proto_init() {
 				return nil
 			}
 		}
+		file_pkg_proto_configuration_tls_tls_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StaticCertificateConfiguration); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_proto_configuration_tls_tls_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcmeCertificateConfiguration); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_proto_configuration_tls_tls_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoteSigner); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_pkg_proto_configuration_tls_tls_proto_msgTypes[0].OneofWrappers = []interface{}{
+		(*ClientConfiguration_ClientPrivateKey)(nil),
+		(*ClientConfiguration_RemoteSigner)(nil),
+		(*ClientConfiguration_ClientPrivateKeyPath)(nil),
+	}
+	file_pkg_proto_configuration_tls_tls_proto_msgTypes[1].OneofWrappers = []interface{}{
+		(*ServerConfiguration_Static)(nil),
+		(*ServerConfiguration_Acme)(nil),
+	}
+	file_pkg_proto_configuration_tls_tls_proto_msgTypes[2].OneofWrappers = []interface{}{
+		(*StaticCertificateConfiguration_ServerPrivateKey)(nil),
+		(*StaticCertificateConfiguration_RemoteSigner)(nil),
+		(*StaticCertificateConfiguration_ServerPrivateKeyPath)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pkg_proto_configuration_tls_tls_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   2,
+			NumEnums:      3,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_pkg_proto_configuration_tls_tls_proto_goTypes,
 		DependencyIndexes: file_pkg_proto_configuration_tls_tls_proto_depIdxs,
+		EnumInfos:         file_pkg_proto_configuration_tls_tls_proto_enumTypes,
 		MessageInfos:      file_pkg_proto_configuration_tls_tls_proto_msgTypes,
 	}.Build()
 	File_pkg_proto_configuration_tls_tls_proto = out.File
@@ -0,0 +1,107 @@
+package tls
+
+import (
+	"crypto/tls"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	configuration_tls "github.com/buildbarn/bb-storage/pkg/proto/configuration/tls"
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/crypto/acme"
+	"golang.org/x/crypto/acme/autocert"
+)
+
+var (
+	tlsACMECertificateRenewalsSuccessfulTotal = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "bb_storage",
+		Subsystem: "tls",
+		Name:      "acme_certificate_renewals_successful_total",
+		Help:      "Number of times a proactive check found a valid ACME certificate, obtaining or renewing it first where needed.",
+	})
+	tlsACMECertificateRenewalsFailedTotal = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "bb_storage",
+		Subsystem: "tls",
+		Name:      "acme_certificate_renewals_failed_total",
+		Help:      "Number of times proactively obtaining or renewing an ACME certificate failed.",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(tlsACMECertificateRenewalsSuccessfulTotal)
+	prometheus.MustRegister(tlsACMECertificateRenewalsFailedTotal)
+}
+
+// acmeRenewalCheckInterval is how frequently each configured host name
+// is proactively checked against autocert.Manager, so that issuance or
+// renewal failures show up in logs and metrics well ahead of the
+// handshake that would otherwise be the first to discover them.
+const acmeRenewalCheckInterval = time.Hour
+
+// newACMETLSConfig creates the tls.Config used to automatically obtain
+// and renew a certificate through the ACME protocol, e.g. from Let's
+// Encrypt. Renewal is handled transparently by autocert.Manager: it is
+// consulted on every handshake and only contacts the ACME server when
+// the cached certificate is absent or nearing expiry.
+//
+// autocert.Manager.TLSConfig() is used instead of assigning only
+// GetCertificate, as it additionally populates NextProtos with the
+// "acme-tls/1" protocol ID required to complete the TLS-ALPN-01
+// challenge. When configuration.challenge_type selects HTTP-01
+// instead, the returned http.Handler must be mounted on port 80 by
+// the caller; it is nil otherwise.
+//
+// Account data and issued certificates are cached on local disk at
+// configuration.cache_directory, if set. There is currently no way to
+// share this cache across replicas; each replica obtains and renews
+// its own certificate independently.
+func newACMETLSConfig(configuration *configuration_tls.AcmeCertificateConfiguration) (*tls.Config, http.Handler, error) {
+	if len(configuration.HostNames) == 0 {
+		return nil, nil, fmt.Errorf("at least one host name must be provided")
+	}
+	if !configuration.AcceptTermsOfService {
+		return nil, nil, fmt.Errorf("the ACME server's terms of service must be accepted by setting accept_terms_of_service")
+	}
+
+	manager := &autocert.Manager{
+		Prompt:     autocert.AcceptTOS,
+		HostPolicy: autocert.HostWhitelist(configuration.HostNames...),
+		Email:      configuration.Email,
+	}
+	if cacheDirectory := configuration.CacheDirectory; cacheDirectory != "" {
+		manager.Cache = autocert.DirCache(cacheDirectory)
+	}
+	if directoryURL := configuration.DirectoryUrl; directoryURL != "" {
+		manager.Client = &acme.Client{DirectoryURL: directoryURL}
+	}
+
+	var httpHandler http.Handler
+	if configuration.ChallengeType == configuration_tls.AcmeChallengeType_ACME_CHALLENGE_TYPE_HTTP_01 {
+		httpHandler = manager.HTTPHandler(nil)
+	}
+	go watchACMERenewals(manager, configuration.HostNames)
+	return manager.TLSConfig(), httpHandler, nil
+}
+
+// watchACMERenewals blocks forever, periodically calling
+// autocert.Manager.GetCertificate() on behalf of every configured host
+// name. autocert.Manager already renews certificates transparently as
+// part of handling a TLS handshake, but doing so proactively on a
+// schedule ensures that issuance and renewal failures are observed
+// (and reflected in Prometheus metrics) even while no clients are
+// connecting, rather than only surfacing as a failed handshake.
+func watchACMERenewals(manager *autocert.Manager, hostNames []string) {
+	ticker := time.NewTicker(acmeRenewalCheckInterval)
+	defer ticker.Stop()
+	for range ticker.C {
+		for _, hostName := range hostNames {
+			if _, err := manager.GetCertificate(&tls.ClientHelloInfo{ServerName: hostName}); err != nil {
+				log.Printf("Failed to obtain or renew ACME certificate for %#v: %s", hostName, err)
+				tlsACMECertificateRenewalsFailedTotal.Inc()
+			} else {
+				tlsACMECertificateRenewalsSuccessfulTotal.Inc()
+			}
+		}
+	}
+}
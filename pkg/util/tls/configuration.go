@@ -0,0 +1,270 @@
+package tls
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	configuration_tls "github.com/buildbarn/bb-storage/pkg/proto/configuration/tls"
+)
+
+// cipherSuitesByName maps the human readable names of cipher suites, as
+// accepted by Go's crypto/tls package, to their numeric identifiers.
+var cipherSuitesByName = func() map[string]uint16 {
+	m := map[string]uint16{}
+	for _, cipherSuite := range tls.CipherSuites() {
+		m[cipherSuite.Name] = cipherSuite.ID
+	}
+	for _, cipherSuite := range tls.InsecureCipherSuites() {
+		m[cipherSuite.Name] = cipherSuite.ID
+	}
+	return m
+}()
+
+// cipherSuitesFromNames converts a list of cipher suite names, as found
+// in ClientConfiguration.cipher_suites or ServerConfiguration.cipher_suites,
+// to the numeric identifiers expected by tls.Config.CipherSuites.
+func cipherSuitesFromNames(names []string) ([]uint16, error) {
+	cipherSuites := make([]uint16, 0, len(names))
+	for _, name := range names {
+		id, ok := cipherSuitesByName[name]
+		if !ok {
+			validNames := make([]string, 0, len(cipherSuitesByName))
+			for validName := range cipherSuitesByName {
+				validNames = append(validNames, validName)
+			}
+			sort.Strings(validNames)
+			return nil, fmt.Errorf("unknown cipher suite %#v, valid cipher suites are: %s", name, strings.Join(validNames, ", "))
+		}
+		cipherSuites = append(cipherSuites, id)
+	}
+	return cipherSuites, nil
+}
+
+// curvePreferencesByName maps the human readable names of elliptic
+// curves, as used in ClientConfiguration.curve_preferences and
+// ServerConfiguration.curve_preferences, to the tls.CurveID values
+// expected by tls.Config.CurvePreferences.
+var curvePreferencesByName = map[string]tls.CurveID{
+	"X25519": tls.X25519,
+	"P256":   tls.CurveP256,
+	"P384":   tls.CurveP384,
+	"P521":   tls.CurveP521,
+}
+
+// curvePreferencesFromNames converts a list of elliptic curve names to
+// the tls.CurveID values expected by tls.Config.CurvePreferences.
+func curvePreferencesFromNames(names []string) ([]tls.CurveID, error) {
+	curvePreferences := make([]tls.CurveID, 0, len(names))
+	for _, name := range names {
+		id, ok := curvePreferencesByName[name]
+		if !ok {
+			validNames := make([]string, 0, len(curvePreferencesByName))
+			for validName := range curvePreferencesByName {
+				validNames = append(validNames, validName)
+			}
+			sort.Strings(validNames)
+			return nil, fmt.Errorf("unknown curve %#v, valid curves are: %s", name, strings.Join(validNames, ", "))
+		}
+		curvePreferences = append(curvePreferences, id)
+	}
+	return curvePreferences, nil
+}
+
+// tlsVersionsByEnum maps TLSVersion enum values to the tls.VersionXXX
+// constants expected by tls.Config.MinVersion/MaxVersion.
+var tlsVersionsByEnum = map[configuration_tls.TLSVersion]uint16{
+	configuration_tls.TLSVersion_TLS_VERSION_SSL3:   tls.VersionSSL30,
+	configuration_tls.TLSVersion_TLS_VERSION_TLS1_0: tls.VersionTLS10,
+	configuration_tls.TLSVersion_TLS_VERSION_TLS1_1: tls.VersionTLS11,
+	configuration_tls.TLSVersion_TLS_VERSION_TLS1_2: tls.VersionTLS12,
+	configuration_tls.TLSVersion_TLS_VERSION_TLS1_3: tls.VersionTLS13,
+}
+
+// tlsVersionFromProto converts a TLSVersion enum value to the numeric
+// identifier expected by tls.Config.MinVersion/MaxVersion. The zero
+// value, TLS_VERSION_UNKNOWN, maps to zero, leaving the bound
+// unconstrained.
+func tlsVersionFromProto(version configuration_tls.TLSVersion) (uint16, error) {
+	if version == configuration_tls.TLSVersion_TLS_VERSION_UNKNOWN {
+		return 0, nil
+	}
+	id, ok := tlsVersionsByEnum[version]
+	if !ok {
+		return 0, fmt.Errorf("unknown TLS version %#v", version.String())
+	}
+	return id, nil
+}
+
+// renegotiationsByEnum maps Renegotiation enum values to the
+// tls.RenegotiationSupport constants expected by
+// tls.Config.Renegotiation.
+var renegotiationsByEnum = map[configuration_tls.Renegotiation]tls.RenegotiationSupport{
+	configuration_tls.Renegotiation_RENEGOTIATION_NEVER:            tls.RenegotiateNever,
+	configuration_tls.Renegotiation_RENEGOTIATION_ONCE_AS_CLIENT:   tls.RenegotiateOnceAsClient,
+	configuration_tls.Renegotiation_RENEGOTIATION_FREELY_AS_CLIENT: tls.RenegotiateFreelyAsClient,
+}
+
+// applyCommonTLSOptions applies the TLS version bounds, curve
+// preferences and cipher suites shared between client and server
+// configurations to a tls.Config.
+func applyCommonTLSOptions(tlsConfig *tls.Config, cipherSuiteNames, curvePreferenceNames []string, minimumTLSVersion, maximumTLSVersion configuration_tls.TLSVersion) error {
+	if len(cipherSuiteNames) > 0 {
+		cipherSuites, err := cipherSuitesFromNames(cipherSuiteNames)
+		if err != nil {
+			return err
+		}
+		tlsConfig.CipherSuites = cipherSuites
+	}
+
+	if len(curvePreferenceNames) > 0 {
+		curvePreferences, err := curvePreferencesFromNames(curvePreferenceNames)
+		if err != nil {
+			return err
+		}
+		tlsConfig.CurvePreferences = curvePreferences
+	}
+
+	minVersion, err := tlsVersionFromProto(minimumTLSVersion)
+	if err != nil {
+		return fmt.Errorf("invalid minimum TLS version: %w", err)
+	}
+	tlsConfig.MinVersion = minVersion
+
+	maxVersion, err := tlsVersionFromProto(maximumTLSVersion)
+	if err != nil {
+		return fmt.Errorf("invalid maximum TLS version: %w", err)
+	}
+	tlsConfig.MaxVersion = maxVersion
+
+	return nil
+}
+
+// NewTLSConfigFromClientConfiguration creates a TLS configuration object
+// based on options specified in a configuration file, for use with
+// network clients. This is used when connecting to schedulers.
+func NewTLSConfigFromClientConfiguration(configuration *configuration_tls.ClientConfiguration) (*tls.Config, error) {
+	if configuration == nil {
+		return nil, nil
+	}
+
+	tlsConfig := &tls.Config{
+		ServerName: configuration.ServerName,
+	}
+
+	if serverCertificateAuthorities := configuration.ServerCertificateAuthorities; serverCertificateAuthorities != "" {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(serverCertificateAuthorities)) {
+			return nil, fmt.Errorf("failed to parse server certificate authorities")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if configuration.ClientCertificatePath != "" && configuration.ClientCertificate != "" {
+		return nil, fmt.Errorf("client_certificate and client_certificate_path are mutually exclusive, but both were provided")
+	}
+	if clientCertificatePath := configuration.ClientCertificatePath; clientCertificatePath != "" {
+		provider, err := NewReloadingCertificateProvider(clientCertificatePath, configuration.GetClientPrivateKeyPath(), configuration.GetReloadInterval().AsDuration())
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+		tlsConfig.GetClientCertificate = provider.GetClientCertificate()
+	} else if clientCertificate := configuration.ClientCertificate; clientCertificate != "" {
+		certificate, err := newCertificate(clientCertificate, configuration.GetClientPrivateKey(), configuration.GetRemoteSigner())
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+
+	if err := applyCommonTLSOptions(tlsConfig, configuration.CipherSuites, configuration.CurvePreferences, configuration.MinimumTlsVersion, configuration.MaximumTlsVersion); err != nil {
+		return nil, err
+	}
+	if renegotiation := configuration.Renegotiation; renegotiation != configuration_tls.Renegotiation_RENEGOTIATION_NEVER {
+		support, ok := renegotiationsByEnum[renegotiation]
+		if !ok {
+			return nil, fmt.Errorf("unknown renegotiation type %#v", renegotiation.String())
+		}
+		tlsConfig.Renegotiation = support
+	}
+
+	return tlsConfig, nil
+}
+
+// NewTLSConfigFromServerConfiguration creates a TLS configuration object
+// based on options specified in a configuration file, for use with
+// network servers. This is used by the RPC server exposed by bb-storage.
+//
+// The returned http.Handler is non-nil only when configuration selects
+// ACME certificate provisioning using the HTTP-01 challenge type. The
+// caller must mount it on port 80 for certificate provisioning to
+// succeed.
+func NewTLSConfigFromServerConfiguration(configuration *configuration_tls.ServerConfiguration) (*tls.Config, http.Handler, error) {
+	if configuration == nil {
+		return nil, nil, nil
+	}
+
+	var acmeHTTPHandler http.Handler
+	tlsConfig := &tls.Config{}
+	switch certificateConfiguration := configuration.GetCertificate().(type) {
+	case *configuration_tls.ServerConfiguration_Static:
+		static := certificateConfiguration.Static
+		if static.ServerCertificatePath != "" && static.ServerCertificate != "" {
+			return nil, nil, fmt.Errorf("server_certificate and server_certificate_path are mutually exclusive, but both were provided")
+		}
+		if serverCertificatePath := static.ServerCertificatePath; serverCertificatePath != "" {
+			provider, err := NewReloadingCertificateProvider(serverCertificatePath, static.GetServerPrivateKeyPath(), static.GetReloadInterval().AsDuration())
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to load server certificate: %w", err)
+			}
+			tlsConfig.GetCertificate = provider.GetCertificate()
+		} else {
+			certificate, err := newCertificate(static.ServerCertificate, static.GetServerPrivateKey(), static.GetRemoteSigner())
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to load server certificate: %w", err)
+			}
+			tlsConfig.Certificates = []tls.Certificate{certificate}
+		}
+	case *configuration_tls.ServerConfiguration_Acme:
+		acmeTLSConfig, httpHandler, err := newACMETLSConfig(certificateConfiguration.Acme)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to configure ACME: %w", err)
+		}
+		tlsConfig.GetCertificate = acmeTLSConfig.GetCertificate
+		tlsConfig.NextProtos = acmeTLSConfig.NextProtos
+		acmeHTTPHandler = httpHandler
+	default:
+		return nil, nil, fmt.Errorf("server certificate configuration is required, but not provided")
+	}
+
+	if err := applyCommonTLSOptions(tlsConfig, configuration.CipherSuites, configuration.CurvePreferences, configuration.MinimumTlsVersion, configuration.MaximumTlsVersion); err != nil {
+		return nil, nil, err
+	}
+	tlsConfig.PreferServerCipherSuites = configuration.PreferServerCipherSuites
+
+	if clientCertificateAuthorities := configuration.ClientCertificateAuthorities; clientCertificateAuthorities != "" {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(clientCertificateAuthorities)) {
+			return nil, nil, fmt.Errorf("failed to parse client certificate authorities")
+		}
+		tlsConfig.ClientCAs = pool
+		tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+
+	return tlsConfig, acmeHTTPHandler, nil
+}
+
+// newCertificate builds a tls.Certificate from a PEM encoded
+// certificate and either a PEM encoded private key or a remote signer
+// configuration. Exactly one of privateKey and remoteSigner is
+// expected to be set, matching the "private_key" oneof found on
+// ClientConfiguration and StaticCertificateConfiguration.
+func newCertificate(certificatePEM, privateKeyPEM string, remoteSigner *configuration_tls.RemoteSigner) (tls.Certificate, error) {
+	if remoteSigner != nil {
+		return newCertificateWithRemoteSigner(certificatePEM, remoteSigner)
+	}
+	return tls.X509KeyPair([]byte(certificatePEM), []byte(privateKeyPEM))
+}
@@ -0,0 +1,72 @@
+package tls
+
+import (
+	"crypto/tls"
+	"testing"
+
+	configuration_tls "github.com/buildbarn/bb-storage/pkg/proto/configuration/tls"
+)
+
+func TestCipherSuitesFromNames(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		cipherSuites, err := cipherSuitesFromNames([]string{"TLS_AES_128_GCM_SHA256"})
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if want := []uint16{tls.TLS_AES_128_GCM_SHA256}; len(cipherSuites) != 1 || cipherSuites[0] != want[0] {
+			t.Fatalf("Got %v, expected %v", cipherSuites, want)
+		}
+	})
+
+	t.Run("UnknownName", func(t *testing.T) {
+		if _, err := cipherSuitesFromNames([]string{"NOT_A_REAL_CIPHER_SUITE"}); err == nil {
+			t.Fatal("Expected an error, got nil")
+		}
+	})
+}
+
+func TestCurvePreferencesFromNames(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		curvePreferences, err := curvePreferencesFromNames([]string{"X25519", "P256"})
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if want := []tls.CurveID{tls.X25519, tls.CurveP256}; len(curvePreferences) != len(want) || curvePreferences[0] != want[0] || curvePreferences[1] != want[1] {
+			t.Fatalf("Got %v, expected %v", curvePreferences, want)
+		}
+	})
+
+	t.Run("UnknownName", func(t *testing.T) {
+		if _, err := curvePreferencesFromNames([]string{"NOT_A_REAL_CURVE"}); err == nil {
+			t.Fatal("Expected an error, got nil")
+		}
+	})
+}
+
+func TestTLSVersionFromProto(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		version configuration_tls.TLSVersion
+		want    uint16
+	}{
+		{"Unknown", configuration_tls.TLSVersion_TLS_VERSION_UNKNOWN, 0},
+		{"TLS1_2", configuration_tls.TLSVersion_TLS_VERSION_TLS1_2, tls.VersionTLS12},
+		{"TLS1_3", configuration_tls.TLSVersion_TLS_VERSION_TLS1_3, tls.VersionTLS13},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := tlsVersionFromProto(tc.version)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+			if got != tc.want {
+				t.Fatalf("Got %d, expected %d", got, tc.want)
+			}
+		})
+	}
+
+	t.Run("UnknownValue", func(t *testing.T) {
+		if _, err := tlsVersionFromProto(configuration_tls.TLSVersion(1000)); err == nil {
+			t.Fatal("Expected an error, got nil")
+		}
+	})
+}
@@ -0,0 +1,75 @@
+package tls
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"fmt"
+
+	"github.com/buildbarn/bb-storage/pkg/proto/remotesigner"
+)
+
+// LocalSigner is a reference implementation of
+// remotesigner.RemoteSignerServer that performs signing in-process,
+// using a crypto.Signer that is already available locally. It is of
+// no use in a genuine Keyless SSL deployment, where the whole point is
+// that the private key is held by a separate, dedicated service.
+// Instead, it exists so that remote signing can be exercised without
+// standing up a real remote signer, e.g. by embedding it into a
+// process that terminates both ends of the gRPC connection.
+type LocalSigner struct {
+	remotesigner.UnimplementedRemoteSignerServer
+
+	signer crypto.Signer
+}
+
+// NewLocalSigner creates a RemoteSignerServer that signs on behalf of
+// a single, locally held private key.
+func NewLocalSigner(signer crypto.Signer) *LocalSigner {
+	return &LocalSigner{signer: signer}
+}
+
+func (ls *LocalSigner) Sign(ctx context.Context, request *remotesigner.SignRequest) (*remotesigner.SignResponse, error) {
+	hash, opts, err := signerOptsFromAlgorithm(request.Algorithm)
+	if err != nil {
+		return nil, err
+	}
+	if len(request.Digest) != hash.Size() {
+		return nil, fmt.Errorf("digest has length %d, while algorithm %s requires a length of %d", len(request.Digest), request.Algorithm, hash.Size())
+	}
+	signature, err := ls.signer.Sign(rand.Reader, request.Digest, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create signature: %w", err)
+	}
+	return &remotesigner.SignResponse{Signature: signature}, nil
+}
+
+// signerOptsFromAlgorithm converts a remotesigner.SignatureAlgorithm,
+// as received over the wire, back into the crypto.Hash and
+// crypto.SignerOpts pair that crypto.Signer.Sign() expects. This is
+// the inverse of remoteSigner.signatureAlgorithm().
+func signerOptsFromAlgorithm(algorithm remotesigner.SignatureAlgorithm) (crypto.Hash, crypto.SignerOpts, error) {
+	switch algorithm {
+	case remotesigner.SignatureAlgorithm_RSA_PKCS1_SHA256:
+		return crypto.SHA256, crypto.SHA256, nil
+	case remotesigner.SignatureAlgorithm_RSA_PKCS1_SHA384:
+		return crypto.SHA384, crypto.SHA384, nil
+	case remotesigner.SignatureAlgorithm_RSA_PKCS1_SHA512:
+		return crypto.SHA512, crypto.SHA512, nil
+	case remotesigner.SignatureAlgorithm_RSA_PSS_SHA256:
+		return crypto.SHA256, &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash, Hash: crypto.SHA256}, nil
+	case remotesigner.SignatureAlgorithm_RSA_PSS_SHA384:
+		return crypto.SHA384, &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash, Hash: crypto.SHA384}, nil
+	case remotesigner.SignatureAlgorithm_RSA_PSS_SHA512:
+		return crypto.SHA512, &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash, Hash: crypto.SHA512}, nil
+	case remotesigner.SignatureAlgorithm_ECDSA_SHA256:
+		return crypto.SHA256, crypto.SHA256, nil
+	case remotesigner.SignatureAlgorithm_ECDSA_SHA384:
+		return crypto.SHA384, crypto.SHA384, nil
+	case remotesigner.SignatureAlgorithm_ECDSA_SHA512:
+		return crypto.SHA512, crypto.SHA512, nil
+	default:
+		return 0, nil, fmt.Errorf("unknown signature algorithm %s", algorithm)
+	}
+}
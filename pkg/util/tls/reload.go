@@ -0,0 +1,173 @@
+package tls
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	tlsCertificateReloadsSuccessfulTotal = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "bb_storage",
+		Subsystem: "tls",
+		Name:      "certificate_reloads_successful_total",
+		Help:      "Number of times a certificate and private key pair were reloaded from disk successfully.",
+	})
+	tlsCertificateReloadsFailedTotal = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "bb_storage",
+		Subsystem: "tls",
+		Name:      "certificate_reloads_failed_total",
+		Help:      "Number of times reloading a certificate and private key pair from disk failed.",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(tlsCertificateReloadsSuccessfulTotal)
+	prometheus.MustRegister(tlsCertificateReloadsFailedTotal)
+}
+
+// defaultReloadInterval is used when a configuration does not specify a
+// reload_interval explicitly.
+const defaultReloadInterval = time.Minute
+
+// certificateExpiryWarningThreshold is how far ahead of a certificate's
+// expiry a warning is logged, so that an operator whose external
+// rotation tooling (e.g. cert-manager or a Vault Agent sidecar) has
+// stalled has time to react before the certificate actually expires.
+const certificateExpiryWarningThreshold = 7 * 24 * time.Hour
+
+// ReloadingCertificateProvider watches a certificate and private key
+// pair on disk and transparently reloads them whenever their contents
+// change, so that certificates rotated by external tooling (e.g.
+// cert-manager or a Vault Agent sidecar) take effect without
+// restarting this process. A filesystem watcher is used to pick up
+// changes promptly. The files are also polled periodically, whether or
+// not the watcher is active, which covers the case where the watcher
+// cannot be set up (e.g. because the files live on a network
+// filesystem that does not support inotify).
+type ReloadingCertificateProvider struct {
+	certificatePath string
+	privateKeyPath  string
+
+	lock        sync.RWMutex
+	certificate tls.Certificate
+}
+
+// NewReloadingCertificateProvider creates a ReloadingCertificateProvider
+// that loads a certificate and private key pair from disk, failing if
+// the initial load is unsuccessful. Subsequent reloads that fail are
+// logged and reflected in Prometheus metrics, leaving the previously
+// loaded certificate in place.
+func NewReloadingCertificateProvider(certificatePath, privateKeyPath string, reloadInterval time.Duration) (*ReloadingCertificateProvider, error) {
+	if reloadInterval <= 0 {
+		reloadInterval = defaultReloadInterval
+	}
+	rcp := &ReloadingCertificateProvider{
+		certificatePath: certificatePath,
+		privateKeyPath:  privateKeyPath,
+	}
+	if err := rcp.reload(); err != nil {
+		return nil, err
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		log.Printf("Failed to create filesystem watcher for TLS certificate %#v, falling back to polling every %s: %s", certificatePath, reloadInterval, err)
+		watcher = nil
+	} else {
+		for _, path := range []string{certificatePath, privateKeyPath} {
+			if err := watcher.Add(path); err != nil {
+				log.Printf("Failed to watch TLS certificate file %#v, falling back to polling every %s: %s", path, reloadInterval, err)
+			}
+		}
+	}
+	go rcp.watch(watcher, reloadInterval)
+	return rcp, nil
+}
+
+// watch blocks forever, reloading the certificate and private key pair
+// whenever the filesystem watcher reports a change or the poll interval
+// elapses, whichever comes first.
+func (rcp *ReloadingCertificateProvider) watch(watcher *fsnotify.Watcher, reloadInterval time.Duration) {
+	ticker := time.NewTicker(reloadInterval)
+	defer ticker.Stop()
+
+	var events <-chan fsnotify.Event
+	if watcher != nil {
+		defer watcher.Close()
+		events = watcher.Events
+	}
+	for {
+		select {
+		case <-ticker.C:
+		case _, ok := <-events:
+			if !ok {
+				events = nil
+				continue
+			}
+		}
+		if err := rcp.reload(); err != nil {
+			log.Printf("Failed to reload TLS certificate %#v: %s", rcp.certificatePath, err)
+			tlsCertificateReloadsFailedTotal.Inc()
+		} else {
+			tlsCertificateReloadsSuccessfulTotal.Inc()
+		}
+	}
+}
+
+// reload reads the certificate and private key pair from disk and
+// atomically swaps it into place. The leaf certificate is parsed so
+// that a warning can be logged if it is already expired or is about to
+// expire, which would otherwise only surface once handshakes relying
+// on it start failing.
+func (rcp *ReloadingCertificateProvider) reload() error {
+	certificate, err := tls.LoadX509KeyPair(rcp.certificatePath, rcp.privateKeyPath)
+	if err != nil {
+		return fmt.Errorf("failed to load certificate and private key: %w", err)
+	}
+
+	leaf, err := x509.ParseCertificate(certificate.Certificate[0])
+	if err != nil {
+		return fmt.Errorf("failed to parse leaf certificate: %w", err)
+	}
+	certificate.Leaf = leaf
+
+	if remaining := time.Until(leaf.NotAfter); remaining <= 0 {
+		log.Printf("TLS certificate %#v expired %s ago", rcp.certificatePath, -remaining)
+	} else if remaining < certificateExpiryWarningThreshold {
+		log.Printf("TLS certificate %#v expires in %s", rcp.certificatePath, remaining)
+	}
+
+	rcp.lock.Lock()
+	rcp.certificate = certificate
+	rcp.lock.Unlock()
+	return nil
+}
+
+// GetCertificate returns a tls.Config.GetCertificate callback that
+// always hands out the most recently loaded certificate.
+func (rcp *ReloadingCertificateProvider) GetCertificate() func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	return func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+		rcp.lock.RLock()
+		defer rcp.lock.RUnlock()
+		certificate := rcp.certificate
+		return &certificate, nil
+	}
+}
+
+// GetClientCertificate returns a tls.Config.GetClientCertificate
+// callback that always hands out the most recently loaded certificate.
+func (rcp *ReloadingCertificateProvider) GetClientCertificate() func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	return func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+		rcp.lock.RLock()
+		defer rcp.lock.RUnlock()
+		certificate := rcp.certificate
+		return &certificate, nil
+	}
+}
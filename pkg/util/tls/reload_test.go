@@ -0,0 +1,86 @@
+package tls
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// writeTestCertificate writes a self-signed certificate and private
+// key pair to disk, for use by ReloadingCertificateProvider tests.
+// serial distinguishes certificates produced by successive calls, so
+// that a test can tell which certificate a provider is currently
+// holding.
+func writeTestCertificate(t *testing.T, certificatePath, privateKeyPath string, serial int64) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("Failed to generate private key: %s", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: "example.com"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("Failed to create certificate: %s", err)
+	}
+	keyBytes, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("Failed to marshal private key: %s", err)
+	}
+	if err := os.WriteFile(certificatePath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600); err != nil {
+		t.Fatalf("Failed to write certificate: %s", err)
+	}
+	if err := os.WriteFile(privateKeyPath, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyBytes}), 0o600); err != nil {
+		t.Fatalf("Failed to write private key: %s", err)
+	}
+}
+
+func TestReloadingCertificateProviderReloadsOnChange(t *testing.T) {
+	dir := t.TempDir()
+	certificatePath := filepath.Join(dir, "cert.pem")
+	privateKeyPath := filepath.Join(dir, "key.pem")
+	writeTestCertificate(t, certificatePath, privateKeyPath, 1)
+
+	provider, err := NewReloadingCertificateProvider(certificatePath, privateKeyPath, 10*time.Millisecond)
+	if err != nil {
+		t.Fatalf("Failed to create provider: %s", err)
+	}
+	getCertificate := provider.GetCertificate()
+
+	first, err := getCertificate(nil)
+	if err != nil {
+		t.Fatalf("Failed to get certificate: %s", err)
+	}
+	if got := first.Leaf.SerialNumber.Int64(); got != 1 {
+		t.Fatalf("Got serial number %d, expected 1", got)
+	}
+
+	writeTestCertificate(t, certificatePath, privateKeyPath, 2)
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		certificate, err := getCertificate(nil)
+		if err != nil {
+			t.Fatalf("Failed to get certificate: %s", err)
+		}
+		if certificate.Leaf.SerialNumber.Int64() == 2 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("Timed out waiting for the certificate to be reloaded")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
@@ -0,0 +1,52 @@
+package tls
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"net"
+	"testing"
+
+	configuration_tls "github.com/buildbarn/bb-storage/pkg/proto/configuration/tls"
+	"github.com/buildbarn/bb-storage/pkg/proto/remotesigner"
+	"google.golang.org/grpc"
+)
+
+// TestRemoteSignerRoundTrip verifies that a signature produced by a
+// remoteSigner dialing a LocalSigner over gRPC verifies against the
+// public key of the private key held by that LocalSigner, i.e. that
+// the two ends of the RemoteSigner service agree on the wire format
+// used to request and return signatures.
+func TestRemoteSignerRoundTrip(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("Failed to generate private key: %s", err)
+	}
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Failed to create listener: %s", err)
+	}
+	server := grpc.NewServer()
+	remotesigner.RegisterRemoteSignerServer(server, NewLocalSigner(key))
+	go server.Serve(listener)
+	defer server.Stop()
+
+	signer, err := newRemoteSigner(&configuration_tls.RemoteSigner{
+		Address: listener.Addr().String(),
+	}, &key.PublicKey)
+	if err != nil {
+		t.Fatalf("Failed to create remote signer: %s", err)
+	}
+
+	digest := sha256.Sum256([]byte("hello world"))
+	signature, err := signer.Sign(rand.Reader, digest[:], crypto.SHA256)
+	if err != nil {
+		t.Fatalf("Failed to sign digest: %s", err)
+	}
+	if !ecdsa.VerifyASN1(&key.PublicKey, digest[:], signature) {
+		t.Fatal("Signature produced by the remote signer does not verify against the public key")
+	}
+}